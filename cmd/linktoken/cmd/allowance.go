@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+var (
+	allowanceOwner   string
+	allowanceSpender string
+)
+
+var allowanceCmd = &cobra.Command{
+	Use:   "allowance",
+	Short: "Print the amount a spender is allowed to transfer from an owner",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		client, err := newClient(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		owner := common.HexToAddress(allowanceOwner)
+		spender := common.HexToAddress(allowanceSpender)
+
+		allowed, err := client.Allowance(ctx, owner, spender)
+		if err != nil {
+			return err
+		}
+
+		return printResult(allowed.String())
+	},
+}
+
+func init() {
+	allowanceCmd.Flags().StringVar(&allowanceOwner, "owner", "", "owner address")
+	allowanceCmd.Flags().StringVar(&allowanceSpender, "spender", "", "spender address")
+
+	_ = allowanceCmd.MarkFlagRequired("owner")
+	_ = allowanceCmd.MarkFlagRequired("spender")
+}
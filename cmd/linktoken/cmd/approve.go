@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+var (
+	approveSpender string
+	approveAmount  string
+)
+
+var approveCmd = &cobra.Command{
+	Use:   "approve",
+	Short: "Approve a spender to transfer LINK on your behalf",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		client, err := newClient(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		amount, ok := new(big.Int).SetString(approveAmount, 10)
+		if !ok {
+			return errInvalidAmount(approveAmount)
+		}
+
+		if err := client.Approve(ctx, common.HexToAddress(approveSpender), amount); err != nil {
+			return err
+		}
+
+		if dryRun {
+			return printDryRun(client)
+		}
+
+		return printResult("ok")
+	},
+}
+
+func init() {
+	approveCmd.Flags().StringVar(&approveSpender, "spender", "", "address to approve as a spender")
+	approveCmd.Flags().StringVar(&approveAmount, "amount", "", "amount to approve, in juels")
+
+	_ = approveCmd.MarkFlagRequired("spender")
+	_ = approveCmd.MarkFlagRequired("amount")
+}
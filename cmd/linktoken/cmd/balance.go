@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+var balanceOf string
+
+var balanceCmd = &cobra.Command{
+	Use:   "balance",
+	Short: "Print the LINK balance of an address",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		client, err := newClient(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		balance, err := client.BalanceOf(ctx, common.HexToAddress(balanceOf))
+		if err != nil {
+			return err
+		}
+
+		return printResult(balance.String())
+	},
+}
+
+func init() {
+	balanceCmd.Flags().StringVar(&balanceOf, "of", "", "address to query")
+	_ = balanceCmd.MarkFlagRequired("of")
+}
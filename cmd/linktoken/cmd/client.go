@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/EasterTheBunny/link-token-test/pkg/linktoken"
+)
+
+// newClient dials --rpc and returns a linktoken.Client configured with the
+// signer selected by --signer, in --dry-run mode if requested. Every
+// subcommand except deploy operates on an already-deployed contract and must
+// pass requireContract=true so a missing --contract is reported as a clean
+// usage error instead of a nil-pointer panic on first use.
+func newClient(ctx context.Context, requireContract bool) (*linktoken.Client, error) {
+	if rpcURL == "" {
+		return nil, fmt.Errorf("--rpc is required")
+	}
+
+	if chainID == 0 {
+		return nil, fmt.Errorf("--chain-id is required")
+	}
+
+	if requireContract && contractAddress == "" {
+		return nil, fmt.Errorf("--contract is required")
+	}
+
+	rpcClient, err := rpc.DialContext(ctx, rpcURL)
+	if err != nil {
+		return nil, fmt.Errorf("dial rpc: %w", err)
+	}
+
+	id := big.NewInt(chainID)
+
+	signer, err := newSigner(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	client := linktoken.New(ethclient.NewClient(rpcClient), signer, id)
+	client.DryRun = dryRun
+
+	if contractAddress != "" {
+		if err := client.Attach(common.HexToAddress(contractAddress)); err != nil {
+			return nil, err
+		}
+	}
+
+	return client, nil
+}
+
+func newSigner(ctx context.Context, chainID *big.Int) (linktoken.Signer, error) {
+	switch signerType {
+	case "key":
+		key := signerKey
+		if key == "" {
+			key = os.Getenv("LINKTOKEN_PRIVATE_KEY")
+		}
+
+		if key == "" {
+			return nil, fmt.Errorf("--key or $LINKTOKEN_PRIVATE_KEY is required for --signer=key")
+		}
+
+		return linktoken.NewECDSASigner(key, chainID)
+
+	case "keystore":
+		if keystoreDir == "" || keystoreAddress == "" {
+			return nil, fmt.Errorf("--keystore-dir and --keystore-address are required for --signer=keystore")
+		}
+
+		passphrase := keystorePassphrase
+		if passphrase == "" {
+			passphrase = os.Getenv("LINKTOKEN_KEYSTORE_PASSPHRASE")
+		}
+
+		return linktoken.NewKeystoreSigner(keystoreDir, common.HexToAddress(keystoreAddress), passphrase, chainID)
+
+	case "remote":
+		if remoteRPCURL == "" || remoteAddress == "" {
+			return nil, fmt.Errorf("--remote-rpc and --remote-address are required for --signer=remote")
+		}
+
+		return linktoken.NewRemoteSigner(ctx, remoteRPCURL, common.HexToAddress(remoteAddress), linktoken.RemoteSignMethod(remoteSignMethod), chainID)
+
+	default:
+		return nil, fmt.Errorf("unknown --signer: %s", signerType)
+	}
+}
+
+func errInvalidAmount(raw string) error {
+	return fmt.Errorf("invalid amount %q: must be a base-10 integer", raw)
+}
+
+// printResult renders v as JSON if --json was passed, otherwise as plain text
+// via its fmt.Stringer/fmt-default formatting.
+func printResult(v interface{}) error {
+	if !jsonOutput {
+		fmt.Println(v)
+
+		return nil
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(v)
+}
+
+// printDryRun reports a Client's last dry-run result, if any, and is called
+// after every state-changing subcommand when --dry-run is set.
+func printDryRun(client *linktoken.Client) error {
+	if client.LastDryRun == nil {
+		return fmt.Errorf("dry run requested but no transaction was built")
+	}
+
+	return printResult(map[string]interface{}{
+		"to":           client.LastDryRun.To,
+		"data":         fmt.Sprintf("0x%x", client.LastDryRun.Data),
+		"gas_estimate": client.LastDryRun.GasEstimate,
+	})
+}
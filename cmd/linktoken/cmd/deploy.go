@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var deployCmd = &cobra.Command{
+	Use:   "deploy",
+	Short: "Deploy a new LinkToken contract",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		client, err := newClient(ctx, false)
+		if err != nil {
+			return err
+		}
+
+		addr, err := client.Deploy(ctx)
+		if err != nil {
+			return err
+		}
+
+		if dryRun {
+			return printDryRun(client)
+		}
+
+		return printResult(addr.Hex())
+	},
+}
@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+var grantMintRoleTo string
+
+var grantMintRoleCmd = &cobra.Command{
+	Use:   "grant-mint-role",
+	Short: "Grant the minter role to an address",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		client, err := newClient(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		if err := client.GrantMintRole(ctx, common.HexToAddress(grantMintRoleTo)); err != nil {
+			return err
+		}
+
+		if dryRun {
+			return printDryRun(client)
+		}
+
+		return printResult("ok")
+	},
+}
+
+func init() {
+	grantMintRoleCmd.Flags().StringVar(&grantMintRoleTo, "to", "", "address to grant the minter role to")
+	_ = grantMintRoleCmd.MarkFlagRequired("to")
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+var (
+	mintTo     string
+	mintAmount string
+)
+
+var mintCmd = &cobra.Command{
+	Use:   "mint",
+	Short: "Mint LINK to an address",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		client, err := newClient(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		amount, ok := new(big.Int).SetString(mintAmount, 10)
+		if !ok {
+			return errInvalidAmount(mintAmount)
+		}
+
+		if err := client.Mint(ctx, common.HexToAddress(mintTo), amount); err != nil {
+			return err
+		}
+
+		if dryRun {
+			return printDryRun(client)
+		}
+
+		return printResult("ok")
+	},
+}
+
+func init() {
+	mintCmd.Flags().StringVar(&mintTo, "to", "", "address to mint to")
+	mintCmd.Flags().StringVar(&mintAmount, "amount", "", "amount to mint, in juels")
+
+	_ = mintCmd.MarkFlagRequired("to")
+	_ = mintCmd.MarkFlagRequired("amount")
+}
@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var mintersCmd = &cobra.Command{
+	Use:   "minters",
+	Short: "List every address currently holding the minter role",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		client, err := newClient(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		minters, err := client.Minters(ctx)
+		if err != nil {
+			return err
+		}
+
+		addrs := make([]string, len(minters))
+		for i, addr := range minters {
+			addrs[i] = addr.Hex()
+		}
+
+		return printResult(addrs)
+	},
+}
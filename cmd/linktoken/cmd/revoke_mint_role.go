@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+var revokeMintRoleTo string
+
+var revokeMintRoleCmd = &cobra.Command{
+	Use:   "revoke-mint-role",
+	Short: "Revoke the minter role from an address",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		client, err := newClient(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		if err := client.RevokeMintRole(ctx, common.HexToAddress(revokeMintRoleTo)); err != nil {
+			return err
+		}
+
+		if dryRun {
+			return printDryRun(client)
+		}
+
+		return printResult("ok")
+	},
+}
+
+func init() {
+	revokeMintRoleCmd.Flags().StringVar(&revokeMintRoleTo, "to", "", "address to revoke the minter role from")
+	_ = revokeMintRoleCmd.MarkFlagRequired("to")
+}
@@ -0,0 +1,70 @@
+// Package cmd implements the linktoken CLI: one subcommand per LinkToken
+// operation, replacing the old config.json + boolean-flag flow.
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var (
+	rpcURL  string
+	chainID int64
+
+	signerType         string
+	signerKey          string
+	keystoreDir        string
+	keystoreAddress    string
+	keystorePassphrase string
+	remoteRPCURL       string
+	remoteAddress      string
+	remoteSignMethod   string
+
+	contractAddress string
+
+	dryRun     bool
+	jsonOutput bool
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "linktoken",
+	Short: "Deploy and interact with the Chainlink LINK token",
+}
+
+// Execute runs the CLI, returning any error encountered.
+func Execute() error {
+	return rootCmd.Execute()
+}
+
+func init() {
+	flags := rootCmd.PersistentFlags()
+
+	flags.StringVar(&rpcURL, "rpc", "", "JSON-RPC URL of the target chain")
+	flags.Int64Var(&chainID, "chain-id", 0, "chain ID of the target chain")
+
+	flags.StringVar(&signerType, "signer", "key", "signer implementation to use: key, keystore, or remote")
+	flags.StringVar(&signerKey, "key", "", "hex-encoded private key (signer=key); falls back to $LINKTOKEN_PRIVATE_KEY")
+	flags.StringVar(&keystoreDir, "keystore-dir", "", "keystore directory (signer=keystore)")
+	flags.StringVar(&keystoreAddress, "keystore-address", "", "account address to unlock (signer=keystore)")
+	flags.StringVar(&keystorePassphrase, "keystore-passphrase", "", "keystore passphrase (signer=keystore); falls back to $LINKTOKEN_KEYSTORE_PASSPHRASE")
+	flags.StringVar(&remoteRPCURL, "remote-rpc", "", "JSON-RPC URL of the remote signer, e.g. Clef (signer=remote)")
+	flags.StringVar(&remoteAddress, "remote-address", "", "address the remote signer signs on behalf of (signer=remote)")
+	flags.StringVar(&remoteSignMethod, "remote-method", "eth_signTransaction", "JSON-RPC method the remote signer exposes (signer=remote)")
+
+	flags.StringVar(&contractAddress, "contract", "", "address of a deployed LinkToken contract")
+
+	flags.BoolVar(&dryRun, "dry-run", false, "print the encoded calldata and estimated gas instead of broadcasting")
+	flags.BoolVar(&jsonOutput, "json", false, "print command output as JSON")
+
+	rootCmd.AddCommand(
+		deployCmd,
+		mintCmd,
+		grantMintRoleCmd,
+		revokeMintRoleCmd,
+		approveCmd,
+		transferCmd,
+		transferFromCmd,
+		balanceCmd,
+		allowanceCmd,
+		mintersCmd,
+	)
+}
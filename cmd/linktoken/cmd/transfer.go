@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+var (
+	transferTo     string
+	transferAmount string
+)
+
+var transferCmd = &cobra.Command{
+	Use:   "transfer",
+	Short: "Transfer LINK to an address",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		client, err := newClient(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		amount, ok := new(big.Int).SetString(transferAmount, 10)
+		if !ok {
+			return errInvalidAmount(transferAmount)
+		}
+
+		if err := client.Transfer(ctx, common.HexToAddress(transferTo), amount); err != nil {
+			return err
+		}
+
+		if dryRun {
+			return printDryRun(client)
+		}
+
+		return printResult("ok")
+	},
+}
+
+func init() {
+	transferCmd.Flags().StringVar(&transferTo, "to", "", "address to transfer to")
+	transferCmd.Flags().StringVar(&transferAmount, "amount", "", "amount to transfer, in juels")
+
+	_ = transferCmd.MarkFlagRequired("to")
+	_ = transferCmd.MarkFlagRequired("amount")
+}
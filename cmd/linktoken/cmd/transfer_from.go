@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+var (
+	transferFromFrom   string
+	transferFromTo     string
+	transferFromAmount string
+)
+
+var transferFromCmd = &cobra.Command{
+	Use:   "transfer-from",
+	Short: "Transfer LINK from one address to another using an existing allowance",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		client, err := newClient(ctx, true)
+		if err != nil {
+			return err
+		}
+
+		amount, ok := new(big.Int).SetString(transferFromAmount, 10)
+		if !ok {
+			return errInvalidAmount(transferFromAmount)
+		}
+
+		from := common.HexToAddress(transferFromFrom)
+		to := common.HexToAddress(transferFromTo)
+
+		if err := client.TransferFrom(ctx, from, to, amount); err != nil {
+			return err
+		}
+
+		if dryRun {
+			return printDryRun(client)
+		}
+
+		return printResult("ok")
+	},
+}
+
+func init() {
+	transferFromCmd.Flags().StringVar(&transferFromFrom, "from", "", "address to transfer from")
+	transferFromCmd.Flags().StringVar(&transferFromTo, "to", "", "address to transfer to")
+	transferFromCmd.Flags().StringVar(&transferFromAmount, "amount", "", "amount to transfer, in juels")
+
+	_ = transferFromCmd.MarkFlagRequired("from")
+	_ = transferFromCmd.MarkFlagRequired("to")
+	_ = transferFromCmd.MarkFlagRequired("amount")
+}
@@ -0,0 +1,18 @@
+// Command linktoken is a CLI for deploying and interacting with the
+// Chainlink LINK token. Run `linktoken --help` for the full list of
+// subcommands.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/EasterTheBunny/link-token-test/cmd/linktoken/cmd"
+)
+
+func main() {
+	if err := cmd.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,284 @@
+// Package linktoken provides a reusable client for deploying and interacting
+// with the Chainlink LINK token (link_token.LinkToken), with transaction
+// signing delegated to a pluggable Signer implementation.
+package linktoken
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/shared/generated/link_token"
+)
+
+// Client wraps a deployed LinkToken contract and the RPC/signer pair needed
+// to send transactions to it.
+type Client struct {
+	rpc     *ethclient.Client
+	signer  Signer
+	chainID *big.Int
+
+	contract *link_token.LinkToken
+
+	nonces *NonceManager
+
+	// DryRun, when true, builds and signs transactions as usual but never
+	// broadcasts them. Instead, each state-changing method populates
+	// LastDryRun with the encoded calldata and an estimated gas cost and
+	// returns without error, so callers (e.g. the CLI's --dry-run flag) can
+	// report what would have been sent. GrantMintRole is the exception when
+	// addr already holds the minter role: it builds nothing and leaves
+	// LastDryRun unchanged.
+	DryRun bool
+
+	// LastDryRun holds the result of the most recent DryRun call.
+	LastDryRun *DryRunInfo
+
+	// ResubmitPolicy controls how long waitMined waits for a transaction to
+	// be mined before bumping its fee and resubmitting; see
+	// WaitMinedWithBump. New sets this to defaultResubmitPolicy.
+	ResubmitPolicy ResubmitPolicy
+}
+
+// New returns a Client that sends transactions over the already-dialed rpc,
+// signed by signer, ready to Deploy or Attach to a LinkToken contract.
+func New(rpc *ethclient.Client, signer Signer, chainID *big.Int) *Client {
+	return &Client{
+		rpc:            rpc,
+		signer:         signer,
+		chainID:        chainID,
+		nonces:         NewNonceManager(rpc),
+		ResubmitPolicy: defaultResubmitPolicy,
+	}
+}
+
+// Attach binds the Client to an already-deployed LinkToken at address.
+func (c *Client) Attach(address common.Address) error {
+	contract, err := link_token.NewLinkToken(address, c.rpc)
+	if err != nil {
+		return fmt.Errorf("attach to token contract: %w", err)
+	}
+
+	c.contract = contract
+
+	return nil
+}
+
+// Deploy deploys a new LinkToken contract and binds the Client to it.
+func (c *Client) Deploy(ctx context.Context) (common.Address, error) {
+	opts, release, err := c.buildTxOpts(ctx)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	addr, tx, contract, err := link_token.DeployLinkToken(opts, c.rpc)
+	release(c.sent(err))
+
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	c.contract = contract
+
+	if c.DryRun {
+		return addr, c.recordDryRun(ctx, tx)
+	}
+
+	if _, err := c.WaitMinedWithBump(ctx, tx, c.ResubmitPolicy); err != nil {
+		return common.Address{}, err
+	}
+
+	return addr, nil
+}
+
+// GrantMintRole grants the minter role to addr, if it does not already hold it.
+func (c *Client) GrantMintRole(ctx context.Context, addr common.Address) error {
+	minters, err := c.contract.GetMinters(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return err
+	}
+
+	for _, minter := range minters {
+		if minter == addr {
+			return nil
+		}
+	}
+
+	opts, release, err := c.buildTxOpts(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := c.contract.GrantMintRole(opts, addr)
+	release(c.sent(err))
+
+	if err != nil {
+		return err
+	}
+
+	return c.waitMined(ctx, tx)
+}
+
+// Mint mints amount of LINK to addr. The Client's signer must already hold
+// the minter role; see GrantMintRole.
+func (c *Client) Mint(ctx context.Context, to common.Address, amount *big.Int) error {
+	opts, release, err := c.buildTxOpts(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := c.contract.Mint(opts, to, amount)
+	release(c.sent(err))
+
+	if err != nil {
+		return err
+	}
+
+	return c.waitMined(ctx, tx)
+}
+
+// Approve authorizes spender to transfer up to amount from the signer's
+// balance.
+func (c *Client) Approve(ctx context.Context, spender common.Address, amount *big.Int) error {
+	opts, release, err := c.buildTxOpts(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := c.contract.Approve(opts, spender, amount)
+	release(c.sent(err))
+
+	if err != nil {
+		return err
+	}
+
+	return c.waitMined(ctx, tx)
+}
+
+// TransferFrom moves amount from the from address to the to address, using an
+// allowance previously granted to the Client's signer via Approve.
+func (c *Client) TransferFrom(ctx context.Context, from, to common.Address, amount *big.Int) error {
+	opts, release, err := c.buildTxOpts(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := c.contract.TransferFrom(opts, from, to, amount)
+	release(c.sent(err))
+
+	if err != nil {
+		return err
+	}
+
+	return c.waitMined(ctx, tx)
+}
+
+// Transfer moves amount from the Client's signer directly to the to address.
+func (c *Client) Transfer(ctx context.Context, to common.Address, amount *big.Int) error {
+	opts, release, err := c.buildTxOpts(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := c.contract.Transfer(opts, to, amount)
+	release(c.sent(err))
+
+	if err != nil {
+		return err
+	}
+
+	return c.waitMined(ctx, tx)
+}
+
+// RevokeMintRole revokes the minter role from addr.
+func (c *Client) RevokeMintRole(ctx context.Context, addr common.Address) error {
+	opts, release, err := c.buildTxOpts(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := c.contract.RevokeMintRole(opts, addr)
+	release(c.sent(err))
+
+	if err != nil {
+		return err
+	}
+
+	return c.waitMined(ctx, tx)
+}
+
+// sent reports whether a transaction built with this Client's current mode
+// was actually broadcast, for reporting back to the NonceManager.
+func (c *Client) sent(err error) bool {
+	return err == nil && !c.DryRun
+}
+
+// Minters returns every address currently holding the minter role.
+func (c *Client) Minters(ctx context.Context) ([]common.Address, error) {
+	return c.contract.GetMinters(&bind.CallOpts{Context: ctx})
+}
+
+// BalanceOf returns the LINK balance of addr.
+func (c *Client) BalanceOf(ctx context.Context, addr common.Address) (*big.Int, error) {
+	return c.contract.BalanceOf(&bind.CallOpts{Context: ctx}, addr)
+}
+
+// Allowance returns the amount spender is authorized to transfer from owner.
+func (c *Client) Allowance(ctx context.Context, owner, spender common.Address) (*big.Int, error) {
+	return c.contract.Allowance(&bind.CallOpts{Context: ctx}, owner, spender)
+}
+
+// buildTxOpts reserves the next nonce for the Client's signer and returns
+// transact options ready to pass to a contract method, along with a release
+// func the caller must invoke with whether the built transaction was
+// actually sent (see NonceManager.Reserve). If the caller reports the send as
+// failed outside of DryRun, release reconciles the nonce counter against the
+// chain, since a rejected or dropped broadcast may have left it out of sync.
+func (c *Client) buildTxOpts(ctx context.Context) (opts *bind.TransactOpts, release func(sent bool), err error) {
+	nonce, nonceRelease, err := c.nonces.Reserve(ctx, c.signer.Address())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	opts, err = c.signer.TransactOpts(ctx)
+	if err != nil {
+		nonceRelease(false, false)
+
+		return nil, nil, err
+	}
+
+	opts.Nonce = big.NewInt(int64(nonce))
+	opts.Value = big.NewInt(0)
+	opts.NoSend = c.DryRun
+
+	if err := c.applyFees(ctx, opts); err != nil {
+		nonceRelease(false, false)
+
+		return nil, nil, err
+	}
+
+	release = func(sent bool) {
+		nonceRelease(sent, !sent && !c.DryRun)
+	}
+
+	return opts, release, nil
+}
+
+// waitMined waits for tx to be mined and checks its receipt status, bumping
+// its fee and resubmitting per the Client's ResubmitPolicy if it is not mined
+// within policy.Timeout. If the Client is in DryRun mode, tx was never
+// broadcast (see buildTxOpts); instead of waiting, waitMined estimates its
+// gas cost and records it on LastDryRun.
+func (c *Client) waitMined(ctx context.Context, tx *types.Transaction) error {
+	if c.DryRun {
+		return c.recordDryRun(ctx, tx)
+	}
+
+	_, err := c.WaitMinedWithBump(ctx, tx, c.ResubmitPolicy)
+
+	return err
+}
@@ -0,0 +1,36 @@
+package linktoken
+
+import (
+	"context"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// DryRunInfo describes a transaction that was built and signed but never
+// broadcast, for use by --dry-run style tooling.
+type DryRunInfo struct {
+	To          *common.Address
+	Data        []byte
+	GasEstimate uint64
+}
+
+func (c *Client) recordDryRun(ctx context.Context, tx *types.Transaction) error {
+	gas, err := c.rpc.EstimateGas(ctx, ethereum.CallMsg{
+		From: c.signer.Address(),
+		To:   tx.To(),
+		Data: tx.Data(),
+	})
+	if err != nil {
+		return err
+	}
+
+	c.LastDryRun = &DryRunInfo{
+		To:          tx.To(),
+		Data:        tx.Data(),
+		GasEstimate: gas,
+	}
+
+	return nil
+}
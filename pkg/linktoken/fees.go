@@ -0,0 +1,210 @@
+package linktoken
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// defaultResubmitPolicy is the ResubmitPolicy a Client uses when none is set
+// explicitly, tuned for the fee spikes common on testnets rather than for any
+// specific production chain.
+var defaultResubmitPolicy = ResubmitPolicy{
+	Timeout:     2 * time.Minute,
+	BumpPercent: 10,
+	Deadline:    10 * time.Minute,
+}
+
+// baseFeeMultiplierNum/baseFeeMultiplierDenom scale the latest base fee when
+// deriving a GasFeeCap, giving the transaction headroom for a few blocks of
+// base fee increase before it needs to be repriced.
+const (
+	baseFeeMultiplierNum   = 2
+	baseFeeMultiplierDenom = 1
+)
+
+// ResubmitPolicy controls WaitMinedWithBump's retry behavior: if tx is not
+// mined within Timeout, it is resubmitted with the same nonce and a fee
+// BumpPercent higher than before, repeating until it is mined or Deadline
+// passes.
+type ResubmitPolicy struct {
+	// Timeout is how long to wait for a mined receipt before bumping fees and
+	// resubmitting.
+	Timeout time.Duration
+	// BumpPercent is the percentage to increase the tip/gas price by on each
+	// resubmission, e.g. 10 for a 10% bump.
+	BumpPercent int64
+	// Deadline is the total time to keep bumping before giving up. Zero means
+	// retry indefinitely.
+	Deadline time.Duration
+}
+
+// supportsDynamicFees reports whether the chain's latest block has a base fee
+// set, i.e. EIP-1559 is active.
+func (c *Client) supportsDynamicFees(ctx context.Context) (bool, error) {
+	header, err := c.rpc.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+
+	return header.BaseFee != nil, nil
+}
+
+// applyFees populates either legacy GasPrice or dynamic GasFeeCap/GasTipCap on
+// opts, depending on whether the chain supports EIP-1559.
+func (c *Client) applyFees(ctx context.Context, opts *bind.TransactOpts) error {
+	dynamic, err := c.supportsDynamicFees(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !dynamic {
+		gasPrice, err := c.rpc.SuggestGasPrice(ctx)
+		if err != nil {
+			return err
+		}
+
+		opts.GasPrice = gasPrice
+
+		return nil
+	}
+
+	header, err := c.rpc.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	tip, err := c.rpc.SuggestGasTipCap(ctx)
+	if err != nil {
+		return err
+	}
+
+	feeCap := new(big.Int).Mul(header.BaseFee, big.NewInt(baseFeeMultiplierNum))
+	feeCap.Div(feeCap, big.NewInt(baseFeeMultiplierDenom))
+	feeCap.Add(feeCap, tip)
+
+	opts.GasTipCap = tip
+	opts.GasFeeCap = feeCap
+
+	return nil
+}
+
+// WaitMinedWithBump waits for tx to be mined, resubmitting with a higher
+// tip/gas price every policy.Timeout until it is mined or policy.Deadline
+// elapses. This guards against the fee spikes common on testnets, where a
+// plain bind.WaitMined can hang indefinitely on an underpriced transaction.
+func (c *Client) WaitMinedWithBump(ctx context.Context, tx *types.Transaction, policy ResubmitPolicy) (*types.Receipt, error) {
+	var deadlineCh <-chan time.Time
+
+	if policy.Deadline > 0 {
+		timer := time.NewTimer(policy.Deadline)
+		defer timer.Stop()
+
+		deadlineCh = timer.C
+	}
+
+	current := tx
+
+	for {
+		receiptCh := make(chan *types.Receipt, 1)
+		errCh := make(chan error, 1)
+
+		waitCtx, cancel := context.WithTimeout(ctx, policy.Timeout)
+
+		go func(tx *types.Transaction) {
+			receipt, err := bind.WaitMined(waitCtx, c.rpc, tx)
+			if err != nil {
+				errCh <- err
+
+				return
+			}
+
+			receiptCh <- receipt
+		}(current)
+
+		select {
+		case receipt := <-receiptCh:
+			cancel()
+
+			if receipt.Status == types.ReceiptStatusFailed {
+				return nil, fmt.Errorf("failed status receipt: %d", receipt.Status)
+			}
+
+			return receipt, nil
+		case err := <-errCh:
+			cancel()
+
+			if err != context.DeadlineExceeded {
+				return nil, err
+			}
+		case <-waitCtx.Done():
+			cancel()
+		case <-deadlineCh:
+			cancel()
+
+			return nil, context.DeadlineExceeded
+		}
+
+		bumped, err := c.bumpAndResubmit(ctx, current, policy.BumpPercent)
+		if err != nil {
+			return nil, err
+		}
+
+		current = bumped
+	}
+}
+
+// bumpAndResubmit resigns and rebroadcasts tx with the same nonce and a
+// BumpPercent higher tip/gas price.
+func (c *Client) bumpAndResubmit(ctx context.Context, tx *types.Transaction, bumpPercent int64) (*types.Transaction, error) {
+	var replacement *types.Transaction
+
+	if tx.Type() == types.DynamicFeeTxType {
+		tip := bump(tx.GasTipCap(), bumpPercent)
+		feeCap := bump(tx.GasFeeCap(), bumpPercent)
+
+		replacement = types.NewTx(&types.DynamicFeeTx{
+			ChainID:   c.chainID,
+			Nonce:     tx.Nonce(),
+			GasTipCap: tip,
+			GasFeeCap: feeCap,
+			Gas:       tx.Gas(),
+			To:        tx.To(),
+			Value:     tx.Value(),
+			Data:      tx.Data(),
+		})
+	} else {
+		gasPrice := bump(tx.GasPrice(), bumpPercent)
+
+		replacement = types.NewTx(&types.LegacyTx{
+			Nonce:    tx.Nonce(),
+			GasPrice: gasPrice,
+			Gas:      tx.Gas(),
+			To:       tx.To(),
+			Value:    tx.Value(),
+			Data:     tx.Data(),
+		})
+	}
+
+	signed, err := c.signer.SignTx(replacement, c.chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.rpc.SendTransaction(ctx, signed); err != nil {
+		return nil, err
+	}
+
+	return signed, nil
+}
+
+// bump returns amount increased by percent percent.
+func bump(amount *big.Int, percent int64) *big.Int {
+	bumped := new(big.Int).Mul(amount, big.NewInt(100+percent))
+
+	return bumped.Div(bumped, big.NewInt(100))
+}
@@ -0,0 +1,147 @@
+package linktoken
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// reconcileTimeout bounds the resync read release makes while still holding
+// addr's lock, so a hung RPC can't block every other caller reserving a
+// nonce for the same address indefinitely.
+const reconcileTimeout = 10 * time.Second
+
+// PendingNoncer is the subset of ethclient.Client needed to seed a
+// NonceManager for an address.
+type PendingNoncer interface {
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+}
+
+// NonceManager tracks the next nonce to use per signer address so that
+// multiple goroutines can fire off Approve/TransferFrom/Mint calls
+// concurrently against the same Client without racing to reuse the same
+// nonce - a common failure mode in load-testing scripts built around this
+// package.
+//
+// Reserve locks the address until the caller reports whether the
+// transaction was actually sent, so nonce allocation stays serialized across
+// the build-sign-send sequence rather than just the read of the counter.
+type NonceManager struct {
+	rpc PendingNoncer
+
+	mu    sync.Mutex
+	addrs map[common.Address]*addrNonce
+}
+
+type addrNonce struct {
+	mu          sync.Mutex
+	next        uint64
+	initialized bool
+}
+
+// NewNonceManager returns a NonceManager that seeds each address's counter
+// from rpc on first use.
+func NewNonceManager(rpc PendingNoncer) *NonceManager {
+	return &NonceManager{
+		rpc:   rpc,
+		addrs: make(map[common.Address]*addrNonce),
+	}
+}
+
+// Reserve returns the next nonce to use for addr and locks addr's counter
+// until release is called. Callers must call release(true, false) if the
+// transaction was actually broadcast, or release(false, false) if it was not
+// (a dry run, or a build/sign failure before send) so the nonce remains
+// available for the next attempt. If a broadcast was attempted but the send
+// itself failed, pass release(false, true): the node may have already
+// rejected or consumed the reserved nonce, so release re-syncs the counter
+// against the chain's pending nonce before unlocking, rather than hand the
+// next caller a value that's gone stale. This resync happens without ever
+// releasing addr's lock, unlike a separate call to Reconcile, so no other
+// caller can slip in and reserve a nonce against the stale value in between.
+func (m *NonceManager) Reserve(ctx context.Context, addr common.Address) (nonce uint64, release func(sent, reconcile bool), err error) {
+	state := m.stateFor(addr)
+
+	state.mu.Lock()
+
+	if !state.initialized {
+		pending, err := m.rpc.PendingNonceAt(ctx, addr)
+		if err != nil {
+			state.mu.Unlock()
+
+			return 0, nil, err
+		}
+
+		state.next = pending
+		state.initialized = true
+	}
+
+	nonce = state.next
+
+	release = func(sent, reconcile bool) {
+		switch {
+		case sent:
+			state.next++
+		case reconcile:
+			// Deliberately not ctx: a broadcast failure is commonly a
+			// deadline exceeded on ctx itself, and resyncing is exactly the
+			// one thing we still want to succeed when that happens.
+			reconcileCtx, cancel := context.WithTimeout(context.Background(), reconcileTimeout)
+			pending, err := m.rpc.PendingNonceAt(reconcileCtx, addr)
+
+			cancel()
+
+			if err != nil {
+				// The resync read failed too; don't keep trusting a counter
+				// that may already be stale, and force the next Reserve to
+				// re-fetch from the chain instead.
+				state.initialized = false
+
+				break
+			}
+
+			state.next = pending
+		}
+
+		state.mu.Unlock()
+	}
+
+	return nonce, release, nil
+}
+
+// Reconcile re-syncs addr's counter with the chain's pending nonce. It is for
+// standalone use (e.g. resetting a Client's state on startup or after an
+// operator-initiated resync); a failed send inside Reserve's release is
+// already reconciled atomically without dropping addr's lock in between, see
+// Reserve.
+func (m *NonceManager) Reconcile(ctx context.Context, addr common.Address) error {
+	state := m.stateFor(addr)
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	pending, err := m.rpc.PendingNonceAt(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	state.next = pending
+	state.initialized = true
+
+	return nil
+}
+
+func (m *NonceManager) stateFor(addr common.Address) *addrNonce {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	state, ok := m.addrs[addr]
+	if !ok {
+		state = &addrNonce{}
+		m.addrs[addr] = state
+	}
+
+	return state
+}
@@ -0,0 +1,103 @@
+package linktoken_test
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/EasterTheBunny/link-token-test/pkg/linktoken"
+)
+
+type fixedPendingNoncer struct {
+	nonce uint64
+}
+
+func (f *fixedPendingNoncer) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return f.nonce, nil
+}
+
+// TestNonceManagerReserveConcurrent fires N goroutines at Reserve for the
+// same address and checks that every nonce handed out is unique and that,
+// together, they form a contiguous run starting at the seeded pending nonce -
+// i.e. no two callers ever see the same nonce and none are skipped.
+func TestNonceManagerReserveConcurrent(t *testing.T) {
+	const (
+		goroutines = 50
+		start      = 7
+	)
+
+	manager := linktoken.NewNonceManager(&fixedPendingNoncer{nonce: start})
+	addr := common.HexToAddress("0x1")
+
+	nonces := make([]uint64, goroutines)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			nonce, release, err := manager.Reserve(context.Background(), addr)
+			if err != nil {
+				t.Errorf("reserve: %s", err)
+
+				return
+			}
+
+			nonces[i] = nonce
+
+			release(true, false)
+		}(i)
+	}
+
+	wg.Wait()
+
+	sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+
+	for i, nonce := range nonces {
+		want := uint64(start + i)
+		if nonce != want {
+			t.Fatalf("nonce at position %d = %d, want %d (nonces: %v)", i, nonce, want, nonces)
+		}
+	}
+}
+
+// TestNonceManagerReserveReconcileOnFailure checks that release(false, true)
+// re-syncs the counter with the chain rather than leaving it pointing at a
+// nonce the node has already rejected or consumed.
+func TestNonceManagerReserveReconcileOnFailure(t *testing.T) {
+	noncer := &fixedPendingNoncer{nonce: 3}
+	manager := linktoken.NewNonceManager(noncer)
+	addr := common.HexToAddress("0x1")
+
+	nonce, release, err := manager.Reserve(context.Background(), addr)
+	if err != nil {
+		t.Fatalf("reserve: %s", err)
+	}
+
+	if nonce != 3 {
+		t.Fatalf("nonce = %d, want 3", nonce)
+	}
+
+	// Simulate the chain having moved on without us between our read of the
+	// pending nonce and our failed send (e.g. another process used it).
+	noncer.nonce = 9
+
+	release(false, true)
+
+	nonce, release, err = manager.Reserve(context.Background(), addr)
+	if err != nil {
+		t.Fatalf("reserve: %s", err)
+	}
+
+	if nonce != 9 {
+		t.Fatalf("nonce after reconcile = %d, want 9", nonce)
+	}
+
+	release(true, false)
+}
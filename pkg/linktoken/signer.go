@@ -0,0 +1,73 @@
+package linktoken
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Signer abstracts the transaction-signing concern away from the Client so
+// that callers can plug in an in-memory key, a keystore-backed account, or a
+// remote signing service (Clef, a hardware wallet daemon, a KMS bridge)
+// without changing any of the contract-interaction code.
+type Signer interface {
+	// Address returns the address this signer signs on behalf of.
+	Address() common.Address
+
+	// SignTx signs tx for the given chain and returns the signed transaction.
+	SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
+
+	// TransactOpts returns a *bind.TransactOpts bound to this signer, suitable
+	// for passing directly to generated contract bindings.
+	TransactOpts(ctx context.Context) (*bind.TransactOpts, error)
+}
+
+// ECDSASigner is an in-memory Signer backed by a raw ECDSA private key.
+type ECDSASigner struct {
+	key     *ecdsa.PrivateKey
+	address common.Address
+	chainID *big.Int
+}
+
+// NewECDSASigner derives a Signer from the hex-encoded private key. Unlike the
+// previous hand-rolled key reconstruction, this validates the key by loading
+// it through crypto.ToECDSA rather than recomputing the public key from a raw
+// scalar multiplication.
+func NewECDSASigner(hexKey string, chainID *big.Int) (*ECDSASigner, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(hexKey), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("parse private key: %w", err)
+	}
+
+	return &ECDSASigner{
+		key:     key,
+		address: crypto.PubkeyToAddress(key.PublicKey),
+		chainID: chainID,
+	}, nil
+}
+
+func (s *ECDSASigner) Address() common.Address {
+	return s.address
+}
+
+func (s *ECDSASigner) SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return types.SignTx(tx, types.LatestSignerForChainID(chainID), s.key)
+}
+
+func (s *ECDSASigner) TransactOpts(ctx context.Context) (*bind.TransactOpts, error) {
+	opts, err := bind.NewKeyedTransactorWithChainID(s.key, s.chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	opts.Context = ctx
+
+	return opts, nil
+}
@@ -0,0 +1,62 @@
+package linktoken
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// KeystoreSigner is a Signer backed by a go-ethereum keystore account, unlocked
+// with a passphrase for the lifetime of the signer. This mirrors the
+// accounts.Manager pattern used by geth itself rather than keeping a raw key
+// in process memory.
+type KeystoreSigner struct {
+	ks      *keystore.KeyStore
+	account accounts.Account
+	chainID *big.Int
+}
+
+// NewKeystoreSigner opens (or attaches to) the keystore at keyDir, unlocks the
+// account matching address with passphrase, and returns a Signer bound to it.
+func NewKeystoreSigner(keyDir string, address common.Address, passphrase string, chainID *big.Int) (*KeystoreSigner, error) {
+	ks := keystore.NewKeyStore(keyDir, keystore.StandardScryptN, keystore.StandardScryptP)
+
+	account, err := ks.Find(accounts.Account{Address: address})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ks.Unlock(account, passphrase); err != nil {
+		return nil, err
+	}
+
+	return &KeystoreSigner{
+		ks:      ks,
+		account: account,
+		chainID: chainID,
+	}, nil
+}
+
+func (s *KeystoreSigner) Address() common.Address {
+	return s.account.Address
+}
+
+func (s *KeystoreSigner) SignTx(tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return s.ks.SignTx(s.account, tx, chainID)
+}
+
+func (s *KeystoreSigner) TransactOpts(ctx context.Context) (*bind.TransactOpts, error) {
+	opts, err := bind.NewKeyStoreTransactorWithChainID(s.ks, s.account, s.chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	opts.Context = ctx
+
+	return opts, nil
+}
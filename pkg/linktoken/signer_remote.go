@@ -0,0 +1,132 @@
+package linktoken
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// RemoteSigner is a Signer that delegates the actual signing to an external
+// JSON-RPC endpoint speaking the standard `eth_signTransaction` /
+// `personal_signTransaction` methods. This lets users point the client at
+// Clef, a hardware wallet daemon, or a KMS-backed signing bridge instead of
+// holding key material in this process.
+type RemoteSigner struct {
+	rpc     *rpc.Client
+	address common.Address
+	method  string
+	chainID *big.Int
+}
+
+// RemoteSignMethod selects which JSON-RPC method is used to request a
+// signature from the remote endpoint.
+type RemoteSignMethod string
+
+const (
+	// MethodEthSignTransaction uses the standard `eth_signTransaction` call.
+	MethodEthSignTransaction RemoteSignMethod = "eth_signTransaction"
+	// MethodPersonalSignTransaction uses the `personal_signTransaction` call,
+	// as exposed by Clef and some wallet daemons.
+	MethodPersonalSignTransaction RemoteSignMethod = "personal_signTransaction"
+)
+
+// NewRemoteSigner dials rpcURL and returns a Signer that signs on behalf of
+// address using the given method. chainID is sent with every signing
+// request so the remote endpoint (Clef, a hardware wallet daemon, a KMS
+// bridge) always knows which chain it is signing for, regardless of what a
+// not-yet-signed transaction's own fields say.
+func NewRemoteSigner(ctx context.Context, rpcURL string, address common.Address, method RemoteSignMethod, chainID *big.Int) (*RemoteSigner, error) {
+	client, err := rpc.DialContext(ctx, rpcURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RemoteSigner{
+		rpc:     client,
+		address: address,
+		method:  string(method),
+		chainID: chainID,
+	}, nil
+}
+
+func (s *RemoteSigner) Address() common.Address {
+	return s.address
+}
+
+// sendTxArgs mirrors the go-ethereum `SendTxArgs` shape expected by
+// eth_signTransaction / personal_signTransaction implementations, including
+// the EIP-1559 fee fields so a dynamic-fee transaction built by the Client's
+// fee logic isn't flattened into a legacy-shaped gasPrice.
+type sendTxArgs struct {
+	From                 common.Address  `json:"from"`
+	To                   *common.Address `json:"to,omitempty"`
+	Gas                  *hexutil.Uint64 `json:"gas,omitempty"`
+	GasPrice             *hexutil.Big    `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *hexutil.Big    `json:"value,omitempty"`
+	Nonce                *hexutil.Uint64 `json:"nonce,omitempty"`
+	Data                 *hexutil.Bytes  `json:"data,omitempty"`
+	ChainID              *hexutil.Big    `json:"chainId,omitempty"`
+}
+
+type signTransactionResult struct {
+	Raw hexutil.Bytes      `json:"raw"`
+	Tx  *types.Transaction `json:"tx"`
+}
+
+// SignTx asks the remote endpoint to sign tx. The chainID parameter is
+// ignored in favor of the Signer's own configured chain ID (see
+// NewRemoteSigner), so the remote endpoint is always told the chain this
+// Client was constructed for, never one derived from an unsigned tx's
+// zero-valued signature fields.
+func (s *RemoteSigner) SignTx(tx *types.Transaction, _ *big.Int) (*types.Transaction, error) {
+	gas := hexutil.Uint64(tx.Gas())
+	value := hexutil.Big(*tx.Value())
+	nonce := hexutil.Uint64(tx.Nonce())
+	data := hexutil.Bytes(tx.Data())
+	chainID := hexutil.Big(*s.chainID)
+
+	args := sendTxArgs{
+		From:    s.address,
+		To:      tx.To(),
+		Gas:     &gas,
+		Value:   &value,
+		Nonce:   &nonce,
+		Data:    &data,
+		ChainID: &chainID,
+	}
+
+	if tx.Type() == types.DynamicFeeTxType {
+		tip := hexutil.Big(*tx.GasTipCap())
+		feeCap := hexutil.Big(*tx.GasFeeCap())
+
+		args.MaxPriorityFeePerGas = &tip
+		args.MaxFeePerGas = &feeCap
+	} else {
+		gasPrice := hexutil.Big(*tx.GasPrice())
+		args.GasPrice = &gasPrice
+	}
+
+	var result signTransactionResult
+	if err := s.rpc.Call(&result, s.method, args); err != nil {
+		return nil, err
+	}
+
+	return result.Tx, nil
+}
+
+func (s *RemoteSigner) TransactOpts(ctx context.Context) (*bind.TransactOpts, error) {
+	return &bind.TransactOpts{
+		From:    s.address,
+		Context: ctx,
+		Signer: func(addr common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			return s.SignTx(tx, s.chainID)
+		},
+	}, nil
+}
@@ -0,0 +1,74 @@
+package linktoken
+
+import (
+	"context"
+	"math/big"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/shared/generated/link_token"
+)
+
+// TransferAndCall moves amount of LINK to the ERC677-aware receiver contract
+// at to in a single transaction, invoking the receiver's onTokenTransfer
+// callback with data. This is the defining feature of the LINK token that
+// plain Transfer/TransferFrom cannot exercise.
+func (c *Client) TransferAndCall(ctx context.Context, to common.Address, amount *big.Int, data []byte) (*types.Receipt, error) {
+	opts, release, err := c.buildTxOpts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := c.contract.TransferAndCall(opts, to, amount, data)
+	release(c.sent(err))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if c.DryRun {
+		return nil, c.recordDryRun(ctx, tx)
+	}
+
+	return c.WaitMinedWithBump(ctx, tx, c.ResubmitPolicy)
+}
+
+// WatchTransferAndCall subscribes to both halves of a TransferAndCall: the
+// LinkToken's own Transfer event, filtered to transfers landing on receiver,
+// and every raw log receiver itself emits, fetched via
+// ethclient.SubscribeFilterLogs. Decoding the token-level Transfer is handled
+// here; decoding receiver's own callback event (e.g.
+// MockLinkReceiverPaymentReceived) is left to the caller's own contract
+// binding, since this package has no reason to know about any particular
+// receiver's ABI. The two subscriptions are combined into one: Unsubscribe
+// tears down both, and Err reports whichever fails first.
+func (c *Client) WatchTransferAndCall(ctx context.Context, from []common.Address, receiver common.Address, transfers chan<- *link_token.LinkTokenTransfer, receiverLogs chan<- types.Log) (event.Subscription, error) {
+	transferSub, err := c.contract.WatchTransfer(&bind.WatchOpts{Context: ctx}, transfers, from, []common.Address{receiver})
+	if err != nil {
+		return nil, err
+	}
+
+	logSub, err := c.rpc.SubscribeFilterLogs(ctx, ethereum.FilterQuery{Addresses: []common.Address{receiver}}, receiverLogs)
+	if err != nil {
+		transferSub.Unsubscribe()
+
+		return nil, err
+	}
+
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer transferSub.Unsubscribe()
+		defer logSub.Unsubscribe()
+
+		select {
+		case err := <-transferSub.Err():
+			return err
+		case err := <-logSub.Err():
+			return err
+		case <-quit:
+			return nil
+		}
+	}), nil
+}
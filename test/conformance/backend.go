@@ -0,0 +1,76 @@
+package conformance
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// Backend is the minimum surface the harness needs from a target chain. It
+// is satisfied by a simulated backend as well as a real JSON-RPC connection
+// to Anvil, Hardhat, or `geth --dev`, so the same scenario corpus runs
+// against any of them.
+type Backend interface {
+	bind.ContractBackend
+
+	// Commit forces pending transactions into a new block where supported
+	// (simulated backends); it is a no-op against a live chain that mines on
+	// its own.
+	Commit()
+
+	// Close releases any resources held by the backend.
+	Close() error
+}
+
+// simulatedBackend adapts backends.SimulatedBackend to the Backend interface.
+type simulatedBackend struct {
+	*backends.SimulatedBackend
+}
+
+func (b *simulatedBackend) Close() error {
+	return b.SimulatedBackend.Close()
+}
+
+// NewSimulatedBackend returns a Backend backed by an in-process simulated
+// chain, pre-funded with the given genesis allocation.
+func NewSimulatedBackend(alloc core.GenesisAlloc, gasLimit uint64) Backend {
+	return &simulatedBackend{backends.NewSimulatedBackend(alloc, gasLimit)}
+}
+
+// liveBackend adapts a real JSON-RPC connection (Anvil, Hardhat, geth --dev)
+// to the Backend interface. Commit is a no-op since these chains mine on
+// their own schedule.
+type liveBackend struct {
+	*ethclient.Client
+}
+
+func (b *liveBackend) Commit() {}
+
+func (b *liveBackend) Close() error {
+	b.Client.Close()
+
+	return nil
+}
+
+// DialBackend connects to a live chain's JSON-RPC endpoint at rpcURL.
+func DialBackend(ctx context.Context, rpcURL string) (Backend, error) {
+	client, err := ethclient.DialContext(ctx, rpcURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &liveBackend{client}, nil
+}
+
+// AdvanceBlocks calls Commit n times, used by scenarios that assert
+// reorg-tolerance / confirmation-depth behavior. On a simulated backend this
+// mines n empty blocks; on a live backend Commit is a no-op, so it returns
+// at once without mining or waiting for any blocks.
+func AdvanceBlocks(b Backend, n int) {
+	for i := 0; i < n; i++ {
+		b.Commit()
+	}
+}
@@ -0,0 +1,171 @@
+package conformance_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/shared/generated/link_token"
+
+	"github.com/EasterTheBunny/link-token-test/contracts/receiver/mock_link_receiver"
+	"github.com/EasterTheBunny/link-token-test/test/conformance"
+)
+
+const simulatedChainID = 1337
+
+// TestConformance runs the declared scenario corpus against a simulated
+// backend and writes a JUnit report for CI. Running against a live Anvil /
+// Hardhat / geth --dev node is a matter of swapping newFixtureWithAddress's
+// call to conformance.NewSimulatedBackend for conformance.DialBackend.
+func TestConformance(t *testing.T) {
+	scenarios, err := conformance.LoadScenarios(filepath.Join("testdata", "scenarios", "mint_and_approve.json"))
+	if err != nil {
+		t.Fatalf("load scenarios: %s", err)
+	}
+
+	results := conformance.Run(context.Background(), scenarios, newFixture)
+
+	if err := conformance.WriteJUnit("conformance-report.xml", results); err != nil {
+		t.Fatalf("write junit report: %s", err)
+	}
+
+	for _, result := range results {
+		result := result
+
+		t.Run(result.Scenario.Name, func(t *testing.T) {
+			if result.Err != nil {
+				t.Fatalf("%s: %s", result.Scenario.Description, result.Err)
+			}
+		})
+	}
+}
+
+func newFixture(ctx context.Context) (*conformance.Fixture, error) {
+	fixture, _, err := newFixtureWithAddress(ctx)
+
+	return fixture, err
+}
+
+func newFixtureWithAddress(ctx context.Context) (*conformance.Fixture, common.Address, error) {
+	accounts := map[string]*ecdsa.PrivateKey{
+		"owner":    mustGenKey(),
+		"receiver": mustGenKey(),
+	}
+
+	alloc := core.GenesisAlloc{}
+	namedAccounts := make(map[string]conformance.Account, len(accounts))
+
+	for name, key := range accounts {
+		addr := crypto.PubkeyToAddress(key.PublicKey)
+		alloc[addr] = core.GenesisAccount{Balance: big.NewInt(0).SetUint64(1e18)}
+		namedAccounts[name] = conformance.Account{Name: name, Key: key, Address: addr}
+	}
+
+	backend := conformance.NewSimulatedBackend(alloc, 8_000_000)
+
+	deployOpts, err := transactOptsFor(accounts["owner"])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	tokenAddr, tx, contract, err := link_token.DeployLinkToken(deployOpts, backend)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	backend.Commit()
+
+	if _, err := waitDeployed(ctx, backend, tx); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return &conformance.Fixture{
+		Backend:  backend,
+		ChainID:  big.NewInt(simulatedChainID),
+		Contract: contract,
+		Accounts: namedAccounts,
+	}, tokenAddr, nil
+}
+
+// TestConformanceTransferAndCall runs the transferAndCall scenario corpus
+// against a simulated backend with a deployed MockLinkReceiver, verifying the
+// receiver's onTokenTransfer callback fires within the payment transaction.
+//
+// This test only runs once `make -C contracts/receiver bindings` has been run
+// with solc/abigen installed; until then MockLinkReceiverMetaData.Bin is
+// empty and the test is skipped, so this scenario is not yet exercised by CI.
+func TestConformanceTransferAndCall(t *testing.T) {
+	if mock_link_receiver.MockLinkReceiverMetaData.Bin == "" {
+		t.Skip("mock_link_receiver: no compiled bytecode checked in; run `make -C contracts/receiver bindings` to enable this test")
+	}
+
+	scenarios, err := conformance.LoadScenarios(filepath.Join("testdata", "scenarios", "transfer_and_call.json"))
+	if err != nil {
+		t.Fatalf("load scenarios: %s", err)
+	}
+
+	results := conformance.Run(context.Background(), scenarios, newReceiverFixture)
+
+	for _, result := range results {
+		result := result
+
+		t.Run(result.Scenario.Name, func(t *testing.T) {
+			if result.Err != nil {
+				t.Fatalf("%s: %s", result.Scenario.Description, result.Err)
+			}
+		})
+	}
+}
+
+func newReceiverFixture(ctx context.Context) (*conformance.Fixture, error) {
+	fixture, tokenAddr, err := newFixtureWithAddress(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := fixture.Accounts["owner"]
+
+	deployOpts, err := transactOptsFor(owner.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	receiverAddr, tx, _, err := mock_link_receiver.DeployMockLinkReceiver(deployOpts, fixture.Backend, tokenAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	fixture.Backend.Commit()
+
+	if _, err := waitDeployed(ctx, fixture.Backend, tx); err != nil {
+		return nil, err
+	}
+
+	fixture.Accounts["receiver_contract"] = conformance.Account{Name: "receiver_contract", Address: receiverAddr}
+
+	return fixture, nil
+}
+
+func mustGenKey() *ecdsa.PrivateKey {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		panic(err)
+	}
+
+	return key
+}
+
+func transactOptsFor(key *ecdsa.PrivateKey) (*bind.TransactOpts, error) {
+	return bind.NewKeyedTransactorWithChainID(key, big.NewInt(simulatedChainID))
+}
+
+func waitDeployed(ctx context.Context, backend conformance.Backend, tx *types.Transaction) (*types.Receipt, error) {
+	return bind.WaitMined(ctx, backend.(bind.DeployBackend), tx)
+}
@@ -0,0 +1,241 @@
+package conformance
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/shared/generated/link_token"
+)
+
+// Account is a named signing key available to scenario steps.
+type Account struct {
+	Name    string
+	Key     *ecdsa.PrivateKey
+	Address common.Address
+}
+
+// Fixture is the isolated state a single Scenario runs against: a freshly
+// deployed LinkToken on backend, plus the named accounts steps refer to.
+type Fixture struct {
+	Backend  Backend
+	ChainID  *big.Int
+	Contract *link_token.LinkToken
+	Accounts map[string]Account
+}
+
+// Result is the outcome of running one Scenario.
+type Result struct {
+	Scenario Scenario
+	Err      error
+	Duration time.Duration
+	Log      []string
+}
+
+// Run executes every scenario against a fresh Fixture produced by newFixture,
+// logging each step as it goes, and returns one Result per scenario.
+func Run(ctx context.Context, scenarios []Scenario, newFixture func(context.Context) (*Fixture, error)) []Result {
+	results := make([]Result, 0, len(scenarios))
+
+	for _, scenario := range scenarios {
+		start := time.Now()
+
+		fixture, err := newFixture(ctx)
+		if err != nil {
+			results = append(results, Result{Scenario: scenario, Err: fmt.Errorf("build fixture: %w", err)})
+
+			continue
+		}
+
+		result := runScenario(ctx, fixture, scenario)
+		result.Duration = time.Since(start)
+
+		fixture.Backend.Close()
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func runScenario(ctx context.Context, f *Fixture, scenario Scenario) Result {
+	var logLines []string
+
+	logf := func(format string, args ...interface{}) {
+		line := fmt.Sprintf(format, args...)
+		logLines = append(logLines, line)
+		log.Printf("[%s] %s", scenario.Name, line)
+	}
+
+	for i, step := range scenario.Steps {
+		logf("step %d: %s", i, step.Action)
+
+		err := runStep(ctx, f, step, logf)
+
+		if step.ExpectError != "" {
+			if err == nil || !contains(err.Error(), step.ExpectError) {
+				return Result{Scenario: scenario, Log: logLines, Err: fmt.Errorf("step %d: expected error containing %q, got %v", i, step.ExpectError, err)}
+			}
+
+			continue
+		}
+
+		if err != nil {
+			return Result{Scenario: scenario, Log: logLines, Err: fmt.Errorf("step %d (%s): %w", i, step.Action, err)}
+		}
+	}
+
+	return Result{Scenario: scenario, Log: logLines}
+}
+
+func runStep(ctx context.Context, f *Fixture, step Step, logf func(string, ...interface{})) error {
+	switch step.Action {
+	case "grant_mint_role":
+		actor := f.Accounts[step.Actor]
+
+		opts, err := transactOpts(f, actor)
+		if err != nil {
+			return err
+		}
+
+		to := f.Accounts[step.To]
+
+		tx, err := f.Contract.GrantMintRole(opts, to.Address)
+		if err != nil {
+			return err
+		}
+
+		f.Backend.Commit()
+
+		return waitMined(ctx, f, tx)
+
+	case "mint":
+		actor := f.Accounts[step.Actor]
+
+		opts, err := transactOpts(f, actor)
+		if err != nil {
+			return err
+		}
+
+		to := f.Accounts[step.To]
+
+		tx, err := f.Contract.Mint(opts, to.Address, step.Amount)
+		if err != nil {
+			return err
+		}
+
+		f.Backend.Commit()
+
+		return waitMined(ctx, f, tx)
+
+	case "approve":
+		actor := f.Accounts[step.Actor]
+
+		opts, err := transactOpts(f, actor)
+		if err != nil {
+			return err
+		}
+
+		to := f.Accounts[step.To]
+
+		tx, err := f.Contract.Approve(opts, to.Address, step.Amount)
+		if err != nil {
+			return err
+		}
+
+		f.Backend.Commit()
+
+		return waitMined(ctx, f, tx)
+
+	case "transfer_from":
+		actor := f.Accounts[step.Actor]
+
+		opts, err := transactOpts(f, actor)
+		if err != nil {
+			return err
+		}
+
+		from := f.Accounts[step.From]
+		to := f.Accounts[step.To]
+
+		tx, err := f.Contract.TransferFrom(opts, from.Address, to.Address, step.Amount)
+		if err != nil {
+			return err
+		}
+
+		f.Backend.Commit()
+
+		return waitMined(ctx, f, tx)
+
+	case "transfer_and_call":
+		actor := f.Accounts[step.Actor]
+
+		opts, err := transactOpts(f, actor)
+		if err != nil {
+			return err
+		}
+
+		to := f.Accounts[step.To]
+
+		tx, err := f.Contract.TransferAndCall(opts, to.Address, step.Amount, []byte(step.Data))
+		if err != nil {
+			return err
+		}
+
+		f.Backend.Commit()
+
+		return waitMined(ctx, f, tx)
+
+	case "confirm_balance":
+		to := f.Accounts[step.To]
+
+		balance, err := f.Contract.BalanceOf(&bind.CallOpts{Context: ctx}, to.Address)
+		if err != nil {
+			return err
+		}
+
+		logf("%s balance: %s", step.To, balance.String())
+
+		if step.ExpectBalance != nil && balance.Cmp(step.ExpectBalance) != 0 {
+			return fmt.Errorf("balance of %s: want %s, got %s", step.To, step.ExpectBalance, balance)
+		}
+
+		return nil
+
+	case "advance_blocks":
+		AdvanceBlocks(f.Backend, step.Blocks)
+
+		return nil
+
+	default:
+		return fmt.Errorf("unknown step action: %s", step.Action)
+	}
+}
+
+func transactOpts(f *Fixture, actor Account) (*bind.TransactOpts, error) {
+	return bind.NewKeyedTransactorWithChainID(actor.Key, f.ChainID)
+}
+
+func waitMined(ctx context.Context, f *Fixture, tx *types.Transaction) error {
+	receipt, err := bind.WaitMined(ctx, f.Backend.(bind.DeployBackend), tx)
+	if err != nil {
+		return err
+	}
+
+	if receipt.Status == types.ReceiptStatusFailed {
+		return fmt.Errorf("failed status receipt: %d", receipt.Status)
+	}
+
+	return nil
+}
+
+func contains(haystack, needle string) bool {
+	return strings.Contains(haystack, needle)
+}
@@ -0,0 +1,78 @@
+package conformance
+
+import (
+	"encoding/xml"
+	"os"
+)
+
+// junitTestSuite and junitTestCase mirror the subset of the JUnit XML schema
+// that CI systems (GitHub Actions, GitLab, Jenkins) parse for test reporting.
+type junitTestSuite struct {
+	XMLName   xml.Name        `xml:"testsuite"`
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      float64       `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit renders results as a JUnit XML report at path, for CI consumption.
+func WriteJUnit(path string, results []Result) error {
+	suite := junitTestSuite{
+		Name:  "conformance",
+		Tests: len(results),
+	}
+
+	for _, result := range results {
+		tc := junitTestCase{
+			Name:      result.Scenario.Name,
+			ClassName: "conformance",
+			Time:      result.Duration.Seconds(),
+			SystemOut: joinLines(result.Log),
+		}
+
+		if result.Err != nil {
+			suite.Failures++
+
+			tc.Failure = &junitFailure{
+				Message: result.Err.Error(),
+				Text:    result.Err.Error(),
+			}
+		}
+
+		suite.TestCases = append(suite.TestCases, tc)
+	}
+
+	out, err := xml.MarshalIndent(suite, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append([]byte(xml.Header), out...), 0o644)
+}
+
+func joinLines(lines []string) string {
+	var out string
+
+	for i, line := range lines {
+		if i > 0 {
+			out += "\n"
+		}
+
+		out += line
+	}
+
+	return out
+}
@@ -0,0 +1,65 @@
+// Package conformance runs a data-declared corpus of LINK token scenarios
+// against a target chain (a simulated backend, or a live Anvil / Hardhat /
+// `geth --dev` node), in the spirit of the devp2p/eth protocol test suites:
+// named scenarios, isolated fixtures, verbose per-step logging, and results
+// emitted as JUnit XML for CI.
+package conformance
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+)
+
+// Scenario is a single named compliance check against the LinkToken
+// contract, declared as data so new cases can be added without touching the
+// runner.
+type Scenario struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Steps       []Step `json:"steps"`
+}
+
+// Step is one action within a Scenario. Action selects the operation; which
+// of the other fields (Actor, From, To, Amount, Data, Blocks) it reads
+// depends on that action.
+type Step struct {
+	// Action names the operation to perform: "mint", "grant_mint_role",
+	// "approve", "transfer_from", "transfer_and_call", "confirm_balance", or
+	// "advance_blocks".
+	Action string `json:"action"`
+
+	// Actor is the scenario-local account name (resolved against the
+	// fixture's account set) that signs this step's transaction, if any.
+	Actor string `json:"actor,omitempty"`
+
+	From   string   `json:"from,omitempty"`
+	To     string   `json:"to,omitempty"`
+	Amount *big.Int `json:"amount,omitempty"`
+	Data   string   `json:"data,omitempty"`
+	Blocks int      `json:"blocks,omitempty"`
+
+	// ExpectError, when non-empty, asserts that the step fails with an error
+	// whose message contains this substring, rather than succeeding.
+	ExpectError string `json:"expect_error,omitempty"`
+
+	// ExpectBalance, when set alongside Action "confirm_balance", asserts the
+	// To account's LINK balance equals this value.
+	ExpectBalance *big.Int `json:"expect_balance,omitempty"`
+}
+
+// LoadScenarios reads a JSON-encoded scenario corpus from path.
+func LoadScenarios(path string) ([]Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read scenarios: %w", err)
+	}
+
+	var scenarios []Scenario
+	if err := json.Unmarshal(data, &scenarios); err != nil {
+		return nil, fmt.Errorf("parse scenarios: %w", err)
+	}
+
+	return scenarios, nil
+}